@@ -1,15 +1,18 @@
 package akave
 
 import (
-	"bytes"
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	stdhash "hash"
 	"io"
 	"io/ioutil"
 	"path"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"akave.ai/akavesdk/sdk"
@@ -18,12 +21,98 @@ import (
 	"github.com/rclone/rclone/fs/hash"
 )
 
+// RootCIDType identifies the Akave RootCID, the content hash Akave
+// reports for every uploaded file. Object.Hash returns the value Akave
+// already computed for it. It is intentionally NOT advertised by
+// Fs.Hashes (see the comment there): newRootCIDHash is a placeholder
+// constructor needed only so the type can be registered, it does not
+// implement Akave's real CID algorithm and must never be relied on to
+// compute a RootCID locally.
+var RootCIDType = hash.RegisterHash("RootCID", "Akave-RootCID", sha256.Size, newRootCIDHash)
+
+// rootCIDHash is a placeholder constructor for RootCIDType's
+// registration; it is not, and must not be treated as, Akave's real
+// CID algorithm. See the comment on RootCIDType and Fs.Hashes.
+type rootCIDHash struct {
+    h stdhash.Hash
+}
+
+func newRootCIDHash() stdhash.Hash {
+    return &rootCIDHash{h: sha256.New()}
+}
+
+func (r *rootCIDHash) Write(p []byte) (int, error) { return r.h.Write(p) }
+func (r *rootCIDHash) Sum(b []byte) []byte          { return r.h.Sum(b) }
+func (r *rootCIDHash) Reset()                       { r.h.Reset() }
+func (r *rootCIDHash) Size() int                    { return r.h.Size() }
+func (r *rootCIDHash) BlockSize() int               { return r.h.BlockSize() }
 
 
-var (
-	errorReadOnly = errors.New("temp error for implementation")
-	timeUnset     = time.Unix(0, 0)
-)
+
+var timeUnset = time.Unix(0, 0)
+
+// bucketNameRe matches the S3-style naming Akave buckets follow.
+var bucketNameRe = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+func validateBucketName(name string) error {
+    if !bucketNameRe.MatchString(name) {
+        return fmt.Errorf("akave: invalid bucket name %q: must be 3-63 characters, lowercase alphanumeric, '.' or '-'", name)
+    }
+    return nil
+}
+
+// computeAlignedRange widens the byte range [offset, offset+limit) (limit
+// < 0 meaning "to EOF") out to whole blockSize blocks, so the caller can
+// fetch/skip whole blocks from the Akave block layout instead of
+// partial ones. skip is how many bytes at the front of the fetched
+// range come before offset and must be discarded once downloaded;
+// alignedLength is -1 when the range extends to EOF.
+func computeAlignedRange(offset, limit, blockSize int64) (alignedStart, alignedLength, skip int64) {
+    if blockSize <= 0 {
+        blockSize = 1048576
+    }
+
+    alignedStart = (offset / blockSize) * blockSize
+    skip = offset - alignedStart
+
+    alignedLength = -1
+    if limit >= 0 {
+        alignedEnd := ((offset + limit + blockSize - 1) / blockSize) * blockSize
+        alignedLength = alignedEnd - alignedStart
+    }
+
+    return alignedStart, alignedLength, skip
+}
+
+// splitListEntry decides how a single file name found in a bucket
+// relates to the dir being listed. matched is false when fileName isn't
+// under dir at all. When recursive is false and fileName has further
+// "/"-separated path segments beyond dir, isDir is true and remote is
+// the path of the single immediate subdirectory that should be
+// synthesized (callers must still dedupe repeats across files).
+// Otherwise remote is fileName itself and isDir is false.
+func splitListEntry(dir, fileName string, recursive bool) (remote string, isDir bool, matched bool) {
+    prefix := dir
+    if prefix != "" {
+        prefix += "/"
+    }
+    if prefix != "" && !strings.HasPrefix(fileName, prefix) {
+        return "", false, false
+    }
+
+    remaining := strings.TrimPrefix(fileName, prefix)
+    if remaining == "" {
+        return "", false, false
+    }
+
+    if !recursive {
+        if i := strings.IndexRune(remaining, '/'); i >= 0 {
+            return path.Join(dir, remaining[:i]), true, true
+        }
+    }
+
+    return fileName, false, true
+}
 
 
 
@@ -104,14 +193,25 @@ Must be a positive integer. Default is 1048576 (1 MiB).`,
 
 // Fs represents a remote akave server
 type Fs struct {
-    name     string
-    root     string
-    features *fs.Features
-    sdk      *sdk.SDK
+    name           string
+    root           string
+    features       *fs.Features
+    sdk            *sdk.SDK
+    blockPartSize  int64
+    maxConcurrency int
 }
 
 var _ fs.Fs = (*Fs)(nil)
 var _ fs.CleanUpper = (*Fs)(nil)
+var _ fs.Purger = (*Fs)(nil)
+var _ fs.ListRer = (*Fs)(nil)
+var _ fs.Copier = (*Fs)(nil)
+var _ fs.Mover = (*Fs)(nil)
+var _ fs.DirMover = (*Fs)(nil)
+
+// listRBatchSize bounds how many entries ListR buffers before handing
+// them to its callback.
+const listRBatchSize = 1000
 
 
 // Object represents a file in Akave storage
@@ -129,7 +229,18 @@ func (f *Fs) Features() *fs.Features {
 }
 
 
-// TODO: understand what is this
+// Hashes returns the supported hash types. RootCIDType is deliberately
+// not advertised here: it identifies Akave's own content-addressed CID,
+// which newRootCIDHash cannot reproduce locally (it's a plain sha256
+// placeholder, not the real CID algorithm). Advertising it would make
+// rclone's generic post-transfer verification and `--checksum` compare
+// a locally-computed sha256 against the remote CID and fail for every
+// file. Object.Hash still returns the real RootCID for callers that
+// request RootCIDType directly, but checksum-based `rclone check`/
+// `sync --checksum` against Akave (the actual goal of adding RootCIDType)
+// is not achievable until newRootCIDHash implements Akave's real CID
+// algorithm locally; until then rclone falls back to size+mtime
+// comparisons here, same as before RootCIDType existed.
 func (f *Fs) Hashes() hash.Set {
 	return hash.Set(hash.None)
 }
@@ -192,29 +303,133 @@ func NewFs(ctx context.Context,name, root string, m configmap.Mapper) (fs.Fs, er
 
     // Initialize your backend (Fs)
     f := &Fs{
-        name: name,
-        root: root,
-        sdk:  akaveSDK,
+        name:           name,
+        root:           root,
+        sdk:            akaveSDK,
+        blockPartSize:  blockPartSize,
+        maxConcurrency: maxConcurrency,
     }
 	// TODO understahnd what is this
 	f.features = (&fs.Features{
 		CanHaveEmptyDirectories: true,
         BucketBased:             true,  // **Enabled**
         BucketBasedRootOK:       true,  // **Enabled**
-	}).Fill(ctx, f)
+	}).Fill(ctx, f).SetListR(f.ListR)
 
     return f, nil
 }
 
 
 // List the objects and directories in dir into entries
-func (f *Fs) List(ctx context.Context, dir string) (fs.DirEntries, error) {    
+func (f *Fs) List(ctx context.Context, dir string) (fs.DirEntries, error) {
     bucketName := f.root
     if bucketName == "" {
         return f.listBuckets(ctx)
     }
 
-    return f.listFilesInDirectory(ctx, bucketName)
+    var entries fs.DirEntries
+    err := f.list(ctx, bucketName, dir, false, func(entry fs.DirEntry) error {
+        entries = append(entries, entry)
+        return nil
+    })
+    if err != nil {
+        return nil, err
+    }
+    return entries, nil
+}
+
+// ListR lists every object under dir recursively into callback, in
+// batches of at most listRBatchSize entries. No directory entries are
+// produced for sub-paths inside the bucket. When the Fs is not rooted at
+// a bucket, it passes the list of buckets only and does not descend into
+// them.
+func (f *Fs) ListR(ctx context.Context, dir string, callback fs.ListRCallback) error {
+    bucketName := f.root
+    if bucketName == "" {
+        entries, err := f.listBuckets(ctx)
+        if err != nil {
+            return err
+        }
+        return callback(entries)
+    }
+
+    var batch fs.DirEntries
+    err := f.list(ctx, bucketName, dir, true, func(entry fs.DirEntry) error {
+        batch = append(batch, entry)
+        if len(batch) >= listRBatchSize {
+            toSend := batch
+            batch = nil
+            return callback(toSend)
+        }
+        return nil
+    })
+    if err != nil {
+        return err
+    }
+    if len(batch) > 0 {
+        return callback(batch)
+    }
+    return nil
+}
+
+// list fetches every file in bucketName via a single ipc.ListFiles call
+// (the SDK exposes no cursor to page through that call, so the whole
+// bucket listing is loaded into memory at once) and passes fn one entry
+// for each file under dir. When recursive is false, only the immediate
+// children of dir are returned: matching files are passed straight to
+// fn and any further "/"-separated path segments are folded into a
+// single synthesized fs.Directory per immediate subdirectory. When
+// recursive is true, every file under dir is passed to fn and no
+// directories are synthesized.
+func (f *Fs) list(ctx context.Context, bucketName, dir string, recursive bool, fn func(fs.DirEntry) error) error {
+    ipc, err := f.sdk.IPC()
+    if err != nil {
+        return err
+    }
+
+    files, err := ipc.ListFiles(ctx, bucketName)
+    if err != nil {
+        return fmt.Errorf("akave: failed to list files in '%s': %w", bucketName, fs.ErrorDirNotFound)
+    }
+
+    seenDirs := make(map[string]struct{})
+
+    for _, file := range files {
+        // Staging objects left behind by an Update whose finalize step
+        // never completed (see updateStagingSuffix) are not real
+        // objects and must not be surfaced by List/ListR.
+        if strings.HasSuffix(file.Name, updateStagingSuffix) {
+            continue
+        }
+
+        dirRemote, isDir, matched := splitListEntry(dir, file.Name, recursive)
+        if !matched {
+            continue
+        }
+
+        if isDir {
+            if _, ok := seenDirs[dirRemote]; ok {
+                continue
+            }
+            seenDirs[dirRemote] = struct{}{}
+            if err := fn(fs.NewDir(dirRemote, timeUnset)); err != nil {
+                return err
+            }
+            continue
+        }
+
+        fileMeta := sdk.FileMeta{
+            RootCID:   file.RootCID,
+            Name:      file.Name,
+            Size:      file.Size,
+            CreatedAt: file.CreatedAt,
+        }
+        if err := fn(f.newObject(file.Name, fileMeta)); err != nil {
+            return err
+        }
+    }
+
+    return nil
 }
 
 // Put the object into the bucket
@@ -243,6 +458,14 @@ func (f *Fs) Put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options .
     bucketName := f.root
     fileName := remote // Assuming 'remote' is the path within the bucket
 
+    // If a previous Update for this path uploaded a replacement but
+    // failed to finalize it after fileName was already deleted (see
+    // updateFinalizeRetries), fileName no longer exists so rclone
+    // reaches Put instead of Update and would otherwise never reclaim
+    // the orphaned staging object.
+    if err := f.sdk.FileDelete(ctx, bucketName, fileName+updateStagingSuffix); err != nil && !errors.Is(err, sdk.ErrObjectNotFound) {
+        return nil, fmt.Errorf("akave: failed to clear stale staging object for '%s': %w", fileName, err)
+    }
 
     fileUpload, err := ipc.CreateFileUpload(ctx, bucketName, fileName, size, in)
     if err != nil {
@@ -271,18 +494,260 @@ func (f *Fs) Put(ctx context.Context, in io.Reader, src fs.ObjectInfo, options .
 
 // TODO: maybe add valiation that there is not file and that the bucket exists(that would take more time)
 // this won't be needed if the backend would provide a detailed error message
-func (f *Fs) Rmdir(ctx context.Context, bucketName string) error {
-	err := f.sdk.DeleteBucket(ctx, bucketName)
-    if err != nil {
+//
+// Rmdir removes the bucket dir names. Like Mkdir, a sub-path inside an
+// already-rooted bucket is a no-op since Akave has no real directories
+// to remove; only a top-level bucket actually gets deleted.
+func (f *Fs) Rmdir(ctx context.Context, dir string) error {
+    bucketName := f.root
+    if bucketName == "" {
+        bucketName = dir
+        if i := strings.IndexRune(dir, '/'); i >= 0 {
+            bucketName = dir[:i]
+        }
+        if bucketName == "" {
+            return nil
+        }
+    } else if dir != "" {
+        return nil
+    }
+
+    if err := f.sdk.DeleteBucket(ctx, bucketName); err != nil {
         return fmt.Errorf("akave: failed to delete bucket '%s': %w", bucketName, err)
     }
 
     return nil
 }
 
-// TODO: create the bucket
+// Mkdir creates the bucket dir refers to. Akave is bucket-based and has
+// no real directories, so this only does something when dir names a
+// top-level bucket; a sub-path inside an already-rooted bucket is a
+// no-op since sub-directories are synthesized from file name prefixes
+// (see List) and Akave supports empty directories via that synthesis.
+// Like Rmdir, when the Fs is already rooted at a bucket, dir=="" refers
+// to that bucket itself (the pre-transfer Mkdir(ctx, "") rclone issues
+// against a not-yet-existing target bucket), so it is created here too,
+// idempotently tolerating an already-existing bucket.
 func (f *Fs) Mkdir(ctx context.Context, dir string) error {
-	return errorReadOnly
+    bucketName := f.root
+    if bucketName == "" {
+        bucketName = dir
+        if i := strings.IndexRune(dir, '/'); i >= 0 {
+            bucketName = dir[:i]
+        }
+        if bucketName == "" {
+            return nil
+        }
+    } else if dir != "" {
+        return nil
+    }
+
+    if err := validateBucketName(bucketName); err != nil {
+        return err
+    }
+
+    ipc, err := f.sdk.IPC()
+    if err != nil {
+        return err
+    }
+
+    if err := ipc.CreateBucket(ctx, bucketName); err != nil {
+        if errors.Is(err, sdk.ErrBucketExists) {
+            return nil
+        }
+        return fmt.Errorf("akave: failed to create bucket '%s': %w", bucketName, err)
+    }
+
+    return nil
+}
+
+// Purge deletes all files under dir (bounded by max_concurrency). When
+// dir is empty (purging the whole bucket this Fs is rooted at) the
+// bucket itself is removed too; purging a sub-prefix only removes the
+// matching files.
+func (f *Fs) Purge(ctx context.Context, dir string) error {
+    bucketName := f.root
+    if bucketName == "" {
+        return errors.New("akave: cannot purge the bucket list root")
+    }
+
+    var entries fs.DirEntries
+    err := f.list(ctx, bucketName, dir, true, func(entry fs.DirEntry) error {
+        entries = append(entries, entry)
+        return nil
+    })
+    if err != nil {
+        return fmt.Errorf("akave: failed to list '%s' for purge: %w", f.fullPath(dir), err)
+    }
+
+    sem := make(chan struct{}, f.concurrency())
+
+    var wg sync.WaitGroup
+    var mu sync.Mutex
+    var firstErr error
+
+    for _, entry := range entries {
+        obj, ok := entry.(fs.Object)
+        if !ok {
+            continue
+        }
+        wg.Add(1)
+        sem <- struct{}{}
+        go func(obj fs.Object) {
+            defer wg.Done()
+            defer func() { <-sem }()
+            if err := obj.Remove(ctx); err != nil {
+                mu.Lock()
+                if firstErr == nil {
+                    firstErr = fmt.Errorf("akave: failed to remove '%s': %w", obj.Remote(), err)
+                }
+                mu.Unlock()
+            }
+        }(obj)
+    }
+    wg.Wait()
+
+    if firstErr != nil {
+        return firstErr
+    }
+
+    if dir == "" {
+        if err := f.sdk.DeleteBucket(ctx, bucketName); err != nil {
+            return fmt.Errorf("akave: failed to delete bucket '%s': %w", bucketName, err)
+        }
+    }
+
+    return nil
+}
+
+// Copy copies src to this remote using Akave's copy-by-CID operation,
+// so the data is referenced rather than downloaded and re-uploaded.
+func (f *Fs) Copy(ctx context.Context, src fs.Object, remote string) (fs.Object, error) {
+    srcObj, ok := src.(*Object)
+    if !ok {
+        return nil, fs.ErrorCantCopy
+    }
+
+    ipc, err := f.sdk.IPC()
+    if err != nil {
+        return nil, err
+    }
+
+    srcBucket := srcObj.fs.root
+    srcFile := srcObj.remote
+    dstBucket := f.root
+    dstFile := remote
+
+    fileMeta, err := ipc.CopyFile(ctx, srcBucket, srcFile, dstBucket, dstFile)
+    if err != nil {
+        return nil, fmt.Errorf("akave: failed to copy '%s' to '%s': %w", srcFile, dstFile, err)
+    }
+
+    return f.newObject(dstFile, fileMeta), nil
+}
+
+// Move renames src to this remote. Akave objects are content
+// addressed, so a move is a server-side copy followed by removing the
+// source; the data itself is never re-uploaded.
+func (f *Fs) Move(ctx context.Context, src fs.Object, remote string) (fs.Object, error) {
+    srcObj, ok := src.(*Object)
+    if !ok {
+        return nil, fs.ErrorCantMove
+    }
+
+    dst, err := f.Copy(ctx, src, remote)
+    if err != nil {
+        return nil, err
+    }
+
+    if err := srcObj.Remove(ctx); err != nil {
+        return nil, fmt.Errorf("akave: copied '%s' to '%s' but failed to remove the source: %w", srcObj.remote, remote, err)
+    }
+
+    return dst, nil
+}
+
+// DirMove renames a bucket or sub-prefix: every file under srcRemote in
+// src is copied to the equivalent path under dstRemote in f (bounded by
+// max_concurrency, and with the srcRemote prefix of each file rewritten
+// to dstRemote) and the source file removed. When srcRemote and
+// dstRemote are both empty and the buckets differ, the now-empty source
+// bucket is removed too; a failure to remove it is only logged.
+func (f *Fs) DirMove(ctx context.Context, src fs.Fs, srcRemote, dstRemote string) error {
+    srcFs, ok := src.(*Fs)
+    if !ok {
+        return fs.ErrorCantDirMove
+    }
+
+    srcBucket := srcFs.root
+    dstBucket := f.root
+
+    var entries fs.DirEntries
+    err := srcFs.list(ctx, srcBucket, srcRemote, true, func(entry fs.DirEntry) error {
+        entries = append(entries, entry)
+        return nil
+    })
+    if err != nil {
+        return fmt.Errorf("akave: failed to list '%s' for dir move: %w", srcFs.fullPath(srcRemote), err)
+    }
+
+    ipc, err := f.sdk.IPC()
+    if err != nil {
+        return err
+    }
+
+    srcPrefix := srcRemote
+    if srcPrefix != "" {
+        srcPrefix += "/"
+    }
+
+    sem := make(chan struct{}, f.concurrency())
+
+    var wg sync.WaitGroup
+    var mu sync.Mutex
+    var firstErr error
+
+    for _, entry := range entries {
+        obj, ok := entry.(*Object)
+        if !ok {
+            continue
+        }
+        dstFile := path.Join(dstRemote, strings.TrimPrefix(obj.remote, srcPrefix))
+        wg.Add(1)
+        sem <- struct{}{}
+        go func(obj *Object, dstFile string) {
+            defer wg.Done()
+            defer func() { <-sem }()
+            if _, err := ipc.CopyFile(ctx, srcBucket, obj.remote, dstBucket, dstFile); err != nil {
+                mu.Lock()
+                if firstErr == nil {
+                    firstErr = fmt.Errorf("akave: failed to move '%s' to '%s': %w", obj.remote, dstFile, err)
+                }
+                mu.Unlock()
+                return
+            }
+            if err := obj.Remove(ctx); err != nil {
+                mu.Lock()
+                if firstErr == nil {
+                    firstErr = fmt.Errorf("akave: copied '%s' to '%s' but failed to remove the source: %w", obj.remote, dstFile, err)
+                }
+                mu.Unlock()
+            }
+        }(obj, dstFile)
+    }
+    wg.Wait()
+
+    if firstErr != nil {
+        return firstErr
+    }
+
+    if srcRemote == "" && dstRemote == "" && srcBucket != dstBucket {
+        if err := srcFs.sdk.DeleteBucket(ctx, srcBucket); err != nil {
+            fs.Logf(f, "akave: failed to remove source bucket '%s' after dir move: %v", srcBucket, err)
+        }
+    }
+
+    return nil
 }
 
 
@@ -331,37 +796,6 @@ func (f *Fs) listFilesInBucket(ctx context.Context, bucketName string) (fs.DirEn
     return entries, nil
 }
 
-// Helper function to list files in a subdirectory
-func (f *Fs) listFilesInDirectory(ctx context.Context, dir string) (fs.DirEntries, error) {
-    ipc, err := f.sdk.IPC()
-    if err != nil {
-        return nil, err
-    }
-    // List files relative to the current directory
-    files, err := ipc.ListFiles(ctx, dir)
-    if err != nil {
-        return nil, fmt.Errorf("akave: failed to list files in '%s': %w", dir, fs.ErrorDirNotFound)
-    }
-
-    var entries fs.DirEntries
-
-    for _, file := range files {
-        remote := file.Name
-
-        fileMeta := sdk.FileMeta{
-            RootCID:   file.RootCID,
-            Name:      remote,
-            Size:      file.Size,
-            CreatedAt: file.CreatedAt,
-        }
-        obj := f.newObject(remote, fileMeta)
-        entries = append(entries, obj)
-  
-    }
-
-    return entries, nil
-}
-
 // NewObject fetches the object from the remote path.
 func (f *Fs) NewObject(ctx context.Context, remote string) (fs.Object, error) {
 
@@ -397,6 +831,15 @@ func (f *Fs) newObject(remote string, fileInfo sdk.FileMeta)  fs.Object {
     } 
 }
 
+// concurrency returns f.maxConcurrency, falling back to the same
+// default NewFs uses if it was never set to a positive value.
+func (f *Fs) concurrency() int {
+    if f.maxConcurrency <= 0 {
+        return 4
+    }
+    return f.maxConcurrency
+}
+
 // fullPath returns the full path by joining root and dir
 func (f *Fs) fullPath(dir string) string {
     if f.root == "" {
@@ -447,9 +890,84 @@ func (o *Object) String() string {
 	return o.remote
 }
 
+// Open opens the object for reading, translating any fs.RangeOption or
+// fs.SeekOption into a block-aligned byte-range read against the Akave
+// block layout: the read is widened to whole block_part_size blocks so
+// CreateFileDownload can skip blocks entirely, then the extra bytes at
+// the front/back are trimmed in memory.
 func (o *Object) Open(ctx context.Context, options ...fs.OpenOption) (io.ReadCloser, error) {
-    // TODO: Implement actual opening logic
-    return ioutil.NopCloser(bytes.NewReader([]byte{})), nil
+    ipc, err := o.fs.sdk.IPC()
+    if err != nil {
+        return nil, err
+    }
+
+    var offset, limit int64 = 0, -1
+    for _, option := range options {
+        switch opt := option.(type) {
+        case *fs.SeekOption:
+            offset = opt.Offset
+        case *fs.RangeOption:
+            offset, limit = opt.Decode(o.Size())
+        default:
+            if option.Mandatory() {
+                fs.Logf(o, "Unsupported mandatory option: %v", option)
+            }
+        }
+    }
+
+    bucketName := o.fs.root
+    fileName := o.remote
+
+    alignedStart, alignedLength, skip := computeAlignedRange(offset, limit, o.fs.blockPartSize)
+
+    download, err := ipc.CreateFileDownload(ctx, bucketName, fileName, alignedStart, alignedLength)
+    if err != nil {
+        if errors.Is(err, sdk.ErrObjectNotFound) {
+            return nil, fs.ErrorObjectNotFound
+        }
+        return nil, fmt.Errorf("akave: failed to open '%s': %w", fileName, err)
+    }
+
+    var r io.Reader = download
+    if skip > 0 {
+        if _, err := io.CopyN(ioutil.Discard, r, skip); err != nil {
+            _ = download.Close()
+            if errors.Is(err, sdk.ErrObjectNotFound) {
+                return nil, fs.ErrorObjectNotFound
+            }
+            return nil, fmt.Errorf("akave: failed to seek to offset %d in '%s': %w", offset, fileName, err)
+        }
+    }
+    if limit >= 0 {
+        r = io.LimitReader(r, limit)
+    }
+
+    return &objectReader{ctx: ctx, r: r, closer: download}, nil
+}
+
+// objectReader wraps an Akave download stream: it checks ctx cancellation
+// on every Read, maps a disappeared-object error from the SDK onto
+// fs.ErrorObjectNotFound, and releases the underlying SDK resources on
+// Close.
+type objectReader struct {
+    ctx    context.Context
+    r      io.Reader
+    closer io.Closer
+}
+
+func (r *objectReader) Read(p []byte) (int, error) {
+    if err := r.ctx.Err(); err != nil {
+        return 0, err
+    }
+    n, err := r.r.Read(p)
+    if err != nil && errors.Is(err, sdk.ErrObjectNotFound) {
+        err = fs.ErrorObjectNotFound
+    }
+    return n, err
+}
+
+func (r *objectReader) Close() error {
+    return r.closer.Close()
 }
 // Remote returns the remote path when printing the object
 func (o *Object) Remote() string {
@@ -466,9 +984,12 @@ func (o *Object) Size() int64 {
     return o.info.Size
 }
 
-// Hash returns the hash of the object (not implemented)
+// Hash returns the Akave RootCID reported for this object
 func (o *Object) Hash(ctx context.Context, ty hash.Type) (string, error) {
-    return "", hash.ErrUnsupported
+    if ty != RootCIDType {
+        return "", hash.ErrUnsupported
+    }
+    return o.info.RootCID, nil
 }
 
 // Storable indicates whether the object can be stored (always true)
@@ -483,42 +1004,94 @@ func (o *Object) SetModTime(ctx context.Context, t time.Time) error {
 
 
 
-// Update updates the object with the contents of the reader (not implemented)
+// updateStagingSuffix marks the temporary object Update uploads the
+// replacement content under, so the existing file is never removed
+// until the new content is confirmed to exist on Akave.
+const updateStagingSuffix = ".rclone-update-tmp"
+
+// updateFinalizeRetries is how many times in total Update attempts to
+// copy the staged replacement into place before giving up. The content is
+// already safely uploaded at that point, so retrying is cheap and
+// turns a transient error into a no-op instead of a failed update.
+const updateFinalizeRetries = 3
+
+// Update replaces the contents of the object. Akave has no in-place
+// replace and CreateFileUpload refuses to overwrite an existing name,
+// so the replacement is uploaded under a staging name first; only once
+// that upload is confirmed is the old file removed and the staged
+// upload copied into place. If the upload itself fails, the old file
+// is never touched. If finalizing fails after the old file is already
+// gone, the new content remains under the staging name rather than
+// being lost, but fileName itself is now missing: a later sync pass
+// sees NewObject return fs.ErrorObjectNotFound and calls Put, not
+// Update, so reclaiming the orphaned staging object happens there
+// (f.list also filters updateStagingSuffix names out of List/ListR so
+// it isn't surfaced as a phantom file in the meantime).
 func (o *Object) Update(ctx context.Context, in io.Reader, src fs.ObjectInfo, options ...fs.OpenOption) error {
-    fmt.Println("trying to execute Update!")
-	return errorReadOnly
-}
+    ipc, err := o.fs.sdk.IPC()
+    if err != nil {
+        return err
+    }
 
+    bucketName := o.fs.root
+    fileName := o.remote
+    size := src.Size()
+    modTime := src.ModTime(ctx)
 
-// Remove removes the object
-func (o *Object) Remove(ctx context.Context) error {
-    return o.fs.sdk.FileDelete(ctx, o.fs.bucketNameFromRemote(o.remote), o.fileNameFromRemote(o.remote))
-}
+    if size < 0 {
+        return errors.New("akave: unknown object size is not supported")
+    }
 
-// Helper methods to extract bucket and file names
-func (f *Fs) bucketNameFromRemote(remote string) string {
-    parts := strings.SplitN(remote, "/", 2)
-    return parts[0]
-}
+    stagingName := fileName + updateStagingSuffix
 
-func (o *Object) fileNameFromRemote(remote string) string {
-    parts := strings.SplitN(remote, "/", 2)
-    if len(parts) > 1 {
-        return parts[1]
+    // Clear any staging object a previous failed Update left behind so
+    // CreateFileUpload doesn't refuse it as a duplicate name.
+    if err := o.fs.sdk.FileDelete(ctx, bucketName, stagingName); err != nil && !errors.Is(err, sdk.ErrObjectNotFound) {
+        return fmt.Errorf("akave: failed to clear stale staging object for '%s': %w", fileName, err)
+    }
+
+    fileUpload, err := ipc.CreateFileUpload(ctx, bucketName, stagingName, size, in)
+    if err != nil {
+        return fmt.Errorf("akave: failed to upload replacement for '%s': %w", fileName, err)
+    }
+
+    if err := o.fs.sdk.FileDelete(ctx, bucketName, fileName); err != nil && !errors.Is(err, sdk.ErrObjectNotFound) {
+        if delErr := o.fs.sdk.FileDelete(ctx, bucketName, stagingName); delErr != nil {
+            fs.Logf(o, "akave: failed to clean up staged replacement '%s': %v", stagingName, delErr)
+        }
+        return fmt.Errorf("akave: failed to remove old '%s' before finalizing update: %w", fileName, err)
+    }
+
+    var finalizeErr error
+    for attempt := 0; attempt < updateFinalizeRetries; attempt++ {
+        if _, finalizeErr = ipc.CopyFile(ctx, bucketName, stagingName, bucketName, fileName); finalizeErr == nil {
+            break
+        }
+    }
+    if finalizeErr != nil {
+        return fmt.Errorf("akave: uploaded replacement for '%s' but failed to finalize it after %d attempts (content is safe under the staged name; a subsequent Put of this path will reclaim it): %w", fileName, updateFinalizeRetries, finalizeErr)
     }
-    return ""
-}
 
-// ------------------------------------------------------------------------------------
+    if delErr := o.fs.sdk.FileDelete(ctx, bucketName, stagingName); delErr != nil {
+        fs.Logf(o, "akave: failed to clean up staging copy '%s': %v", stagingName, delErr)
+    }
 
-func (f *Fs) split(remote string) (bucket, file string) {
-    parts := strings.SplitN(remote, "/", 2)
-    if len(parts) < 2 {
-        return "", ""
+    o.info = sdk.FileMeta{
+        RootCID:   fileUpload.RootCID,
+        Name:      o.info.Name,
+        Size:      size,
+        CreatedAt: modTime,
     }
-    bucket = parts[0]
-    file = parts[1]
-    return
+
+    return nil
+}
+
+
+// Remove removes the object. Like Open/Update, the bucket is o.fs.root
+// and o.remote is the file path within it (it does not itself carry a
+// bucket prefix).
+func (o *Object) Remove(ctx context.Context) error {
+    return o.fs.sdk.FileDelete(ctx, o.fs.root, o.remote)
 }
 func (f *Fs) CleanUp(ctx context.Context) error {
     fmt.Println("running close function!!")
@@ -0,0 +1,26 @@
+package akave
+
+import "testing"
+
+func TestValidateBucketName(t *testing.T) {
+    for _, tc := range []struct {
+        name      string
+        wantValid bool
+    }{
+        {"abc", true},
+        {"my-bucket.01", true},
+        {"ab", false},             // too short
+        {"-leading-hyphen", false}, // must start/end alphanumeric
+        {"trailing-hyphen-", false},
+        {"Uppercase", false},
+        {"has_underscore", false},
+        {"has space", false},
+    } {
+        t.Run(tc.name, func(t *testing.T) {
+            err := validateBucketName(tc.name)
+            if (err == nil) != tc.wantValid {
+                t.Errorf("validateBucketName(%q) error = %v, wantValid %v", tc.name, err, tc.wantValid)
+            }
+        })
+    }
+}
@@ -0,0 +1,33 @@
+package akave
+
+import "testing"
+
+func TestSplitListEntry(t *testing.T) {
+    for _, tc := range []struct {
+        name                 string
+        dir, fileName        string
+        recursive            bool
+        wantRemote           string
+        wantIsDir, wantMatch bool
+    }{
+        {"root file", "", "file.txt", false, "file.txt", false, true},
+        {"root subdir folded non-recursive", "", "sub/file.txt", false, "sub", true, true},
+        {"root subdir expanded recursive", "", "sub/file.txt", true, "sub/file.txt", false, true},
+        {"file directly in dir", "sub", "sub/file.txt", false, "sub/file.txt", false, true},
+        {"nested subdir folded non-recursive", "sub", "sub/nested/file.txt", false, "sub/nested", true, true},
+        {"nested subdir expanded recursive", "sub", "sub/nested/file.txt", true, "sub/nested/file.txt", false, true},
+        {"unrelated prefix excluded", "sub", "other/file.txt", false, "", false, false},
+        {"prefix-like but not a path boundary excluded", "sub", "subother/file.txt", false, "", false, false},
+        {"exact dir match excluded", "sub", "sub", false, "", false, false},
+    } {
+        t.Run(tc.name, func(t *testing.T) {
+            remote, isDir, matched := splitListEntry(tc.dir, tc.fileName, tc.recursive)
+            if remote != tc.wantRemote || isDir != tc.wantIsDir || matched != tc.wantMatch {
+                t.Errorf("splitListEntry(%q, %q, %v) = (%q, %v, %v), want (%q, %v, %v)",
+                    tc.dir, tc.fileName, tc.recursive,
+                    remote, isDir, matched,
+                    tc.wantRemote, tc.wantIsDir, tc.wantMatch)
+            }
+        })
+    }
+}
@@ -0,0 +1,29 @@
+package akave
+
+import "testing"
+
+func TestComputeAlignedRange(t *testing.T) {
+    for _, tc := range []struct {
+        name                                        string
+        offset, limit, blockSize                     int64
+        wantStart, wantLength, wantSkip              int64
+    }{
+        {"whole file", 0, -1, 1024, 0, -1, 0},
+        {"offset mid first block", 100, -1, 1024, 0, -1, 100},
+        {"offset on block boundary", 1024, -1, 1024, 1024, -1, 0},
+        {"range within one block", 100, 50, 1024, 0, 1024, 100},
+        {"range spanning two blocks", 1000, 100, 1024, 0, 2048, 1000},
+        {"range starting in second block", 1100, 50, 1024, 1024, 1024, 76},
+        {"zero block size falls back to default", 10, 10, 0, 0, 1048576, 10},
+    } {
+        t.Run(tc.name, func(t *testing.T) {
+            start, length, skip := computeAlignedRange(tc.offset, tc.limit, tc.blockSize)
+            if start != tc.wantStart || length != tc.wantLength || skip != tc.wantSkip {
+                t.Errorf("computeAlignedRange(%d, %d, %d) = (%d, %d, %d), want (%d, %d, %d)",
+                    tc.offset, tc.limit, tc.blockSize,
+                    start, length, skip,
+                    tc.wantStart, tc.wantLength, tc.wantSkip)
+            }
+        })
+    }
+}